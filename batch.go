@@ -0,0 +1,167 @@
+package rocketflag
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// errBatchUnavailable signals that the server does not support the batch
+// evaluation endpoint, so GetFlags should fall back to fanning out
+// individual GetFlag calls.
+var errBatchUnavailable = errors.New("rocketflag: batch endpoint unavailable")
+
+// BatchError reports per-flag failures from a GetFlags call. The flags that
+// were resolved successfully are still returned alongside this error.
+type BatchError struct {
+	Failures map[string]error
+}
+
+func (e *BatchError) Error() string {
+	ids := make([]string, 0, len(e.Failures))
+	for id := range e.Failures {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = fmt.Sprintf("%s: %v", id, e.Failures[id])
+	}
+	return fmt.Sprintf("rocketflag: %d flag(s) failed: %s", len(e.Failures), strings.Join(parts, "; "))
+}
+
+// WithBatchConcurrency sets how many concurrent GetFlag calls GetFlags may
+// issue when falling back to client-side fan-out. The default is 1.
+func WithBatchConcurrency(n int) ClientOption {
+	return func(c *Client) {
+		c.batchConcurrency = n
+	}
+}
+
+// GetFlags fetches many flags in a single call. It prefers the server's
+// batch evaluation endpoint, falling back to a client-side fan-out of
+// individual GetFlag calls (bounded by WithBatchConcurrency) if the server
+// doesn't support it. Per-flag failures are reported via a *BatchError
+// without losing the flags that did resolve successfully.
+func (c *Client) GetFlags(ctx context.Context, flagIDs []string, userContext UserContext) (map[string]*FlagStatus, error) {
+	results, err := c.batchEvaluate(ctx, flagIDs, userContext)
+	switch {
+	case err == nil:
+		return results, nil
+	case errors.Is(err, errBatchUnavailable):
+		return c.fanOutFlags(ctx, flagIDs, userContext)
+	default:
+		var batchErr *BatchError
+		if errors.As(err, &batchErr) {
+			return results, err
+		}
+		return nil, err
+	}
+}
+
+type batchEvaluateRequest struct {
+	IDs     []string    `json:"ids"`
+	Context UserContext `json:"context,omitempty"`
+}
+
+// batchEvaluate calls the server's POST /v1/flags:batchEvaluate endpoint. It
+// returns errBatchUnavailable if the server responds 404, so callers can
+// fall back to fanning out individual requests.
+func (c *Client) batchEvaluate(ctx context.Context, flagIDs []string, userContext UserContext) (map[string]*FlagStatus, error) {
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline && c.defaultTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.defaultTimeout)
+		defer cancel()
+	}
+
+	body, err := json.Marshal(batchEvaluateRequest{IDs: flagIDs, Context: userContext})
+	if err != nil {
+		return nil, fmt.Errorf("error encoding batch request: %w", err)
+	}
+
+	u := fmt.Sprintf("%s/%s/flags:batchEvaluate", c.apiUrl, c.version)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errBatchUnavailable
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error from server: %s", resp.Status)
+	}
+
+	var decoded map[string]*FlagStatus
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	failures := make(map[string]error)
+	for _, id := range flagIDs {
+		if decoded[id] == nil {
+			failures[id] = fmt.Errorf("flag not present in batch response")
+		}
+	}
+	if len(failures) > 0 {
+		return decoded, &BatchError{Failures: failures}
+	}
+	return decoded, nil
+}
+
+// fanOutFlags fetches each flag with an individual GetFlag call, bounded by
+// the Client's batch concurrency, and aggregates the results.
+func (c *Client) fanOutFlags(ctx context.Context, flagIDs []string, userContext UserContext) (map[string]*FlagStatus, error) {
+	concurrency := c.batchConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		mu       sync.Mutex
+		results  = make(map[string]*FlagStatus, len(flagIDs))
+		failures = make(map[string]error)
+		wg       sync.WaitGroup
+		sem      = make(chan struct{}, concurrency)
+	)
+
+	for _, flagID := range flagIDs {
+		flagID := flagID
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			flag, err := c.GetFlagWithContext(ctx, flagID, userContext)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures[flagID] = err
+				return
+			}
+			results[flagID] = flag
+		}()
+	}
+	wg.Wait()
+
+	if len(failures) > 0 {
+		return results, &BatchError{Failures: failures}
+	}
+	return results, nil
+}
@@ -0,0 +1,109 @@
+package rocketflag
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestGetFlags_BatchEndpoint_Success(t *testing.T) {
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/flags:batchEvaluate" {
+			t.Fatalf("Expected POST /v1/flags:batchEvaluate, got %s %s", req.Method, req.URL.Path)
+		}
+		body, _ := json.Marshal(map[string]*FlagStatus{
+			"a": {ID: "a", Enabled: true},
+			"b": {ID: "b", Enabled: false},
+		})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+
+	client := NewClient(WithHTTPClient(&http.Client{Transport: rt}))
+
+	flags, err := client.GetFlags(context.Background(), []string{"a", "b"}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(flags) != 2 || !flags["a"].Enabled || flags["b"].Enabled {
+		t.Errorf("Unexpected flags: %+v", flags)
+	}
+}
+
+func TestGetFlags_BatchEndpoint_PartialFailure(t *testing.T) {
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := json.Marshal(map[string]*FlagStatus{
+			"a": {ID: "a", Enabled: true},
+		})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+
+	client := NewClient(WithHTTPClient(&http.Client{Transport: rt}))
+
+	flags, err := client.GetFlags(context.Background(), []string{"a", "missing"}, nil)
+	if err == nil {
+		t.Fatal("Expected a BatchError, got nil")
+	}
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("Expected a *BatchError, got: %T", err)
+	}
+	if _, ok := batchErr.Failures["missing"]; !ok {
+		t.Errorf("Expected failure recorded for 'missing', got: %+v", batchErr.Failures)
+	}
+	if flags["a"] == nil || !flags["a"].Enabled {
+		t.Errorf("Expected successful flags to still be returned, got: %+v", flags)
+	}
+}
+
+func TestGetFlags_FallsBackToFanOutWhenBatchEndpointMissing(t *testing.T) {
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/v1/flags:batchEvaluate" {
+			return resp(http.StatusNotFound, nil), nil
+		}
+		id := req.URL.Path[len("/v1/flags/"):]
+		body, _ := json.Marshal(&FlagStatus{ID: id, Enabled: true})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+
+	client := NewClient(WithHTTPClient(&http.Client{Transport: rt}), WithBatchConcurrency(2))
+
+	flags, err := client.GetFlags(context.Background(), []string{"a", "b", "c"}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(flags) != 3 {
+		t.Errorf("Expected 3 flags, got %d", len(flags))
+	}
+}
+
+func TestGetFlags_FanOutReportsPerFlagFailures(t *testing.T) {
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/v1/flags:batchEvaluate" {
+			return resp(http.StatusNotFound, nil), nil
+		}
+		id := req.URL.Path[len("/v1/flags/"):]
+		if id == "bad" {
+			return resp(http.StatusInternalServerError, nil), nil
+		}
+		body, _ := json.Marshal(&FlagStatus{ID: id, Enabled: true})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+
+	client := NewClient(WithHTTPClient(&http.Client{Transport: rt}))
+
+	flags, err := client.GetFlags(context.Background(), []string{"good", "bad"}, nil)
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("Expected a *BatchError, got: %v", err)
+	}
+	if _, ok := batchErr.Failures["bad"]; !ok {
+		t.Errorf("Expected failure recorded for 'bad', got: %+v", batchErr.Failures)
+	}
+	if flags["good"] == nil || !flags["good"].Enabled {
+		t.Errorf("Expected 'good' to resolve successfully, got: %+v", flags)
+	}
+}
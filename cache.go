@@ -0,0 +1,176 @@
+package rocketflag
+
+import (
+	"container/list"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// cacheEntry is a cached flag lookup with its expiry.
+type cacheEntry struct {
+	key     string
+	flag    *FlagStatus
+	expires time.Time
+}
+
+// flagCache is an in-process, thread-safe LRU cache of flag lookups, with
+// singleflight coalescing of concurrent misses for the same key.
+type flagCache struct {
+	ttl        time.Duration
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+
+	group singleflight.Group
+}
+
+// newFlagCache creates an empty flagCache with the given default TTL and
+// maximum entry count. A non-positive maxEntries means unbounded.
+func newFlagCache(ttl time.Duration, maxEntries int) *flagCache {
+	return &flagCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// cacheKey canonicalizes a flagID and UserContext into a stable string, so
+// that contexts differing only in key order share the same cache entry.
+func cacheKey(flagID string, userContext UserContext) string {
+	keys := make([]string, 0, len(userContext))
+	for k := range userContext {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	ordered := make([]struct {
+		K string      `json:"k"`
+		V interface{} `json:"v"`
+	}, len(keys))
+	for i, k := range keys {
+		ordered[i].K = k
+		ordered[i].V = userContext[k]
+	}
+
+	ctxJSON, _ := json.Marshal(ordered)
+	return flagID + "|" + string(ctxJSON)
+}
+
+func (fc *flagCache) get(key string) (*FlagStatus, bool) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	el, ok := fc.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*cacheEntry)
+	if time.Now().After(entry.expires) {
+		fc.order.Remove(el)
+		delete(fc.entries, key)
+		return nil, false
+	}
+	fc.order.MoveToFront(el)
+	return entry.flag, true
+}
+
+func (fc *flagCache) set(key string, flag *FlagStatus, ttl time.Duration) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	expires := time.Now().Add(ttl)
+	if el, ok := fc.entries[key]; ok {
+		fc.order.MoveToFront(el)
+		el.Value.(*cacheEntry).flag = flag
+		el.Value.(*cacheEntry).expires = expires
+		return
+	}
+
+	el := fc.order.PushFront(&cacheEntry{key: key, flag: flag, expires: expires})
+	fc.entries[key] = el
+
+	if fc.maxEntries > 0 && fc.order.Len() > fc.maxEntries {
+		oldest := fc.order.Back()
+		fc.order.Remove(oldest)
+		delete(fc.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (fc *flagCache) invalidateFlag(flagID string) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	prefix := flagID + "|"
+	for key, el := range fc.entries {
+		if strings.HasPrefix(key, prefix) {
+			fc.order.Remove(el)
+			delete(fc.entries, key)
+		}
+	}
+}
+
+func (fc *flagCache) invalidateAll() {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	fc.entries = make(map[string]*list.Element)
+	fc.order.Init()
+}
+
+// WithCache enables caching of GetFlag responses for ttl, evicting the least
+// recently used entry once more than maxEntries are cached. A non-positive
+// maxEntries means the cache is unbounded.
+func WithCache(ttl time.Duration, maxEntries int) ClientOption {
+	return func(c *Client) {
+		c.cache = newFlagCache(ttl, maxEntries)
+	}
+}
+
+// InvalidateFlag removes all cached entries for flagID, across every cached
+// user context. It is a no-op if caching is not enabled.
+func (c *Client) InvalidateFlag(flagID string) {
+	if c.cache != nil {
+		c.cache.invalidateFlag(flagID)
+	}
+}
+
+// InvalidateAll clears the entire flag cache. It is a no-op if caching is
+// not enabled.
+func (c *Client) InvalidateAll() {
+	if c.cache != nil {
+		c.cache.invalidateAll()
+	}
+}
+
+// cacheTTL derives the TTL a response should be cached for from its
+// Cache-Control header, falling back to the Client's configured TTL. It
+// returns a negative duration if the response asked not to be cached.
+func cacheTTL(c *Client, resp *http.Response) time.Duration {
+	if c.cache == nil {
+		return 0
+	}
+
+	for _, directive := range strings.Split(resp.Header.Get("Cache-Control"), ",") {
+		directive = strings.TrimSpace(directive)
+		switch {
+		case directive == "no-cache" || directive == "no-store":
+			return -1
+		case strings.HasPrefix(directive, "max-age="):
+			if secs, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age=")); err == nil {
+				return time.Duration(secs) * time.Second
+			}
+		}
+	}
+
+	return c.cache.ttl
+}
@@ -0,0 +1,190 @@
+package rocketflag
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCache_HitAvoidsSecondRequest(t *testing.T) {
+	var calls int32
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		body, _ := json.Marshal(&FlagStatus{ID: "123", Name: "test-flag", Enabled: true})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+
+	client := NewClient(WithHTTPClient(&http.Client{Transport: rt}), WithCache(time.Minute, 10))
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetFlag("123", UserContext{"cohort": "beta"}); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected 1 HTTP call, got %d", calls)
+	}
+}
+
+func TestCache_CanonicalizesUserContextKeyOrder(t *testing.T) {
+	keyA := cacheKey("123", UserContext{"cohort": "beta", "id": 1})
+	keyB := cacheKey("123", UserContext{"id": 1, "cohort": "beta"})
+
+	if keyA != keyB {
+		t.Errorf("Expected equivalent user contexts to produce the same key, got %q and %q", keyA, keyB)
+	}
+}
+
+func TestCache_ExpiredEntryIsRefetched(t *testing.T) {
+	var calls int32
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		body, _ := json.Marshal(&FlagStatus{ID: "123"})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+
+	client := NewClient(WithHTTPClient(&http.Client{Transport: rt}), WithCache(time.Millisecond, 10))
+
+	if _, err := client.GetFlag("123", nil); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if _, err := client.GetFlag("123", nil); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected 2 HTTP calls after expiry, got %d", calls)
+	}
+}
+
+func TestCache_ConcurrentMissesCoalesce(t *testing.T) {
+	var calls int32
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(10 * time.Millisecond)
+		body, _ := json.Marshal(&FlagStatus{ID: "123"})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+
+	client := NewClient(WithHTTPClient(&http.Client{Transport: rt}), WithCache(time.Minute, 10))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetFlag("123", nil); err != nil {
+				t.Errorf("Expected no error, got: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("Expected concurrent misses to coalesce into 1 HTTP call, got %d", calls)
+	}
+}
+
+func TestCache_InvalidateFlag(t *testing.T) {
+	var calls int32
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		body, _ := json.Marshal(&FlagStatus{ID: "123"})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+
+	client := NewClient(WithHTTPClient(&http.Client{Transport: rt}), WithCache(time.Minute, 10))
+
+	client.GetFlag("123", nil)
+	client.InvalidateFlag("123")
+	client.GetFlag("123", nil)
+
+	if calls != 2 {
+		t.Errorf("Expected invalidation to force a refetch, got %d calls", calls)
+	}
+}
+
+func TestCache_MaxAgeOverridesConfiguredTTL(t *testing.T) {
+	var calls int32
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		body, _ := json.Marshal(&FlagStatus{ID: "123"})
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(body)),
+			Header:     http.Header{"Cache-Control": []string{"max-age=0"}},
+		}, nil
+	})
+
+	client := NewClient(WithHTTPClient(&http.Client{Transport: rt}), WithCache(time.Hour, 10))
+
+	client.GetFlag("123", nil)
+	time.Sleep(time.Millisecond)
+	client.GetFlag("123", nil)
+
+	if calls != 2 {
+		t.Errorf("Expected max-age=0 to override the configured TTL, got %d calls", calls)
+	}
+}
+
+func TestCache_NoCacheDirectiveSkipsStorage(t *testing.T) {
+	var calls int32
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		body, _ := json.Marshal(&FlagStatus{ID: "123"})
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(body)),
+			Header:     http.Header{"Cache-Control": []string{"no-cache"}},
+		}, nil
+	})
+
+	client := NewClient(WithHTTPClient(&http.Client{Transport: rt}), WithCache(time.Hour, 10))
+
+	client.GetFlag("123", nil)
+	client.GetFlag("123", nil)
+
+	if calls != 2 {
+		t.Errorf("Expected Cache-Control: no-cache to bypass storage, got %d calls", calls)
+	}
+}
+
+func BenchmarkGetFlag_CacheHit(b *testing.B) {
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := json.Marshal(&FlagStatus{ID: "123"})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+	client := NewClient(WithHTTPClient(&http.Client{Transport: rt}), WithCache(time.Hour, 10))
+	client.GetFlag("123", nil) // warm the cache
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.GetFlag("123", nil); err != nil {
+			b.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+}
+
+func BenchmarkGetFlag_Uncached(b *testing.B) {
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := json.Marshal(&FlagStatus{ID: "123"})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+	client := NewClient(WithHTTPClient(&http.Client{Transport: rt}))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.GetFlag("123", nil); err != nil {
+			b.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+}
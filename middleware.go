@@ -0,0 +1,63 @@
+package rocketflag
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// RoundTripFunc adapts an ordinary function to the http.RoundTripper
+// interface. It is also the signature a Middleware wraps.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps a RoundTripFunc to add cross-cutting behavior such as
+// logging, metrics, or tracing. The chain runs inside the retry loop, so
+// each attempt is observed separately, and beneath the cache layer, so a
+// cache hit does not run the chain.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// WithMiddleware appends mw to the Client's middleware chain. Middlewares
+// run in the order given: the first one registered is the outermost wrapper.
+func WithMiddleware(mw ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, mw...)
+	}
+}
+
+// chain composes the Client's middleware around base, preserving
+// registration order (the first registered middleware is outermost).
+func (c *Client) chain(base RoundTripFunc) RoundTripFunc {
+	rt := base
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		rt = c.middleware[i](rt)
+	}
+	return rt
+}
+
+// roundTripAttempt runs req through the middleware chain for a single
+// attempt. If a middleware panics, the response from the underlying HTTP
+// round trip (if any) is still closed so the panic can't leak a connection,
+// and the panic is converted into an error.
+func (c *Client) roundTripAttempt(req *http.Request) (resp *http.Response, err error) {
+	var last *http.Response
+	base := RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		res, e := c.client.Do(r)
+		last = res
+		return res, e
+	})
+
+	defer func() {
+		if p := recover(); p != nil {
+			if last != nil {
+				last.Body.Close()
+			}
+			resp, err = nil, fmt.Errorf("rocketflag: middleware panic: %v", p)
+		}
+	}()
+
+	return c.chain(base)(req)
+}
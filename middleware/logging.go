@@ -0,0 +1,41 @@
+// Package middleware provides built-in rocketflag.Middleware implementations
+// for logging, metrics, and tracing.
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	rocketflag "github.com/RocketFlag/go-sdk"
+)
+
+// Logging returns a Middleware that logs the method, URL, status, and
+// duration of each request attempt at debug level.
+func Logging(logger *slog.Logger) rocketflag.Middleware {
+	return func(next rocketflag.RoundTripFunc) rocketflag.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			duration := time.Since(start)
+
+			if err != nil {
+				logger.Debug("rocketflag request failed",
+					"method", req.Method,
+					"url", req.URL.String(),
+					"duration", duration,
+					"error", err,
+				)
+				return resp, err
+			}
+
+			logger.Debug("rocketflag request",
+				"method", req.Method,
+				"url", req.URL.String(),
+				"status", resp.StatusCode,
+				"duration", duration,
+			)
+			return resp, err
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	rocketflag "github.com/RocketFlag/go-sdk"
+	"github.com/RocketFlag/go-sdk/middleware"
+)
+
+func TestLogging_LogsMethodURLStatusAndDuration(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	rt := rocketflag.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := json.Marshal(&rocketflag.FlagStatus{ID: "123"})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+
+	client := rocketflag.NewClient(
+		rocketflag.WithHTTPClient(&http.Client{Transport: rt}),
+		rocketflag.WithMiddleware(middleware.Logging(logger)),
+	)
+
+	if _, err := client.GetFlag("123", nil); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"method=GET", "status=200", "duration="} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected log output to contain %q, got: %s", want, out)
+		}
+	}
+}
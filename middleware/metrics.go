@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	rocketflag "github.com/RocketFlag/go-sdk"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics returns a Middleware that registers and records
+// rocketflag_requests_total and rocketflag_request_duration_seconds against
+// reg, labeled by flag ID and response status.
+func Metrics(reg prometheus.Registerer) rocketflag.Middleware {
+	requestsTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "rocketflag_requests_total",
+		Help: "Total RocketFlag API requests, labeled by flag and status.",
+	}, []string{"flag", "status"})
+
+	requestDuration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "rocketflag_request_duration_seconds",
+		Help: "RocketFlag API request duration in seconds, labeled by flag and status.",
+	}, []string{"flag", "status"})
+
+	reg.MustRegister(requestsTotal, requestDuration)
+
+	return func(next rocketflag.RoundTripFunc) rocketflag.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			duration := time.Since(start)
+
+			status := "error"
+			if err == nil {
+				status = strconv.Itoa(resp.StatusCode)
+			}
+			flag := flagIDFromPath(req.URL.Path)
+
+			requestsTotal.WithLabelValues(flag, status).Inc()
+			requestDuration.WithLabelValues(flag, status).Observe(duration.Seconds())
+
+			return resp, err
+		}
+	}
+}
+
+// flagIDFromPath extracts the flag ID from a GetFlag request path, which is
+// of the form "/v1/flags/{id}".
+func flagIDFromPath(path string) string {
+	if idx := strings.LastIndex(path, "/"); idx != -1 {
+		return path[idx+1:]
+	}
+	return path
+}
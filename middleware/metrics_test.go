@@ -0,0 +1,67 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	rocketflag "github.com/RocketFlag/go-sdk"
+	"github.com/RocketFlag/go-sdk/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestMetrics_RecordsRequestsTotalAndDuration(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	rt := rocketflag.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := json.Marshal(&rocketflag.FlagStatus{ID: "123"})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+
+	client := rocketflag.NewClient(
+		rocketflag.WithHTTPClient(&http.Client{Transport: rt}),
+		rocketflag.WithMiddleware(middleware.Metrics(reg)),
+	)
+
+	if _, err := client.GetFlag("123", nil); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Expected no error gathering metrics, got: %v", err)
+	}
+
+	var sawCounter, sawHistogram bool
+	for _, mf := range families {
+		switch mf.GetName() {
+		case "rocketflag_requests_total":
+			sawCounter = true
+			assertLabelValue(t, mf, "flag", "123")
+			assertLabelValue(t, mf, "status", "200")
+		case "rocketflag_request_duration_seconds":
+			sawHistogram = true
+		}
+	}
+	if !sawCounter {
+		t.Error("Expected rocketflag_requests_total to be registered and populated")
+	}
+	if !sawHistogram {
+		t.Error("Expected rocketflag_request_duration_seconds to be registered and populated")
+	}
+}
+
+func assertLabelValue(t *testing.T, mf *dto.MetricFamily, label, value string) {
+	t.Helper()
+	for _, m := range mf.GetMetric() {
+		for _, lp := range m.GetLabel() {
+			if lp.GetName() == label && lp.GetValue() == value {
+				return
+			}
+		}
+	}
+	t.Errorf("Expected metric %s to have label %s=%s", mf.GetName(), label, value)
+}
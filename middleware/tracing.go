@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"net/http"
+
+	rocketflag "github.com/RocketFlag/go-sdk"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OTelTracing returns a Middleware that starts a span named
+// "rocketflag.GetFlag" around each request attempt, with attributes for the
+// flag ID and response status code.
+func OTelTracing() rocketflag.Middleware {
+	tracer := otel.Tracer("github.com/RocketFlag/go-sdk")
+
+	return func(next rocketflag.RoundTripFunc) rocketflag.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			ctx, span := tracer.Start(req.Context(), "rocketflag.GetFlag", trace.WithAttributes(
+				attribute.String("rocketflag.flag_id", flagIDFromPath(req.URL.Path)),
+			))
+			defer span.End()
+
+			resp, err := next(req.WithContext(ctx))
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return resp, err
+			}
+
+			span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+			return resp, err
+		}
+	}
+}
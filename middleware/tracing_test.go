@@ -0,0 +1,63 @@
+package middleware_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	rocketflag "github.com/RocketFlag/go-sdk"
+	"github.com/RocketFlag/go-sdk/middleware"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestOTelTracing_StartsSpanWithAttributes(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	rt := rocketflag.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := json.Marshal(&rocketflag.FlagStatus{ID: "123"})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+
+	client := rocketflag.NewClient(
+		rocketflag.WithHTTPClient(&http.Client{Transport: rt}),
+		rocketflag.WithMiddleware(middleware.OTelTracing()),
+	)
+
+	if _, err := client.GetFlag("123", nil); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := provider.ForceFlush(context.Background()); err != nil {
+		t.Fatalf("Expected no error flushing spans, got: %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+
+	span := spans[0]
+	if span.Name != "rocketflag.GetFlag" {
+		t.Errorf("Expected span name 'rocketflag.GetFlag', got %q", span.Name)
+	}
+
+	attrs := map[string]bool{}
+	for _, attr := range span.Attributes {
+		attrs[string(attr.Key)] = true
+	}
+	if !attrs["rocketflag.flag_id"] {
+		t.Error("Expected span to carry a rocketflag.flag_id attribute")
+	}
+	if !attrs["http.status_code"] {
+		t.Error("Expected span to carry an http.status_code attribute")
+	}
+}
@@ -0,0 +1,95 @@
+package rocketflag
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func recordingMiddleware(name string, order *[]string) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			*order = append(*order, name)
+			return next(req)
+		}
+	}
+}
+
+func TestMiddleware_RunsInRegistrationOrder(t *testing.T) {
+	var order []string
+
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		order = append(order, "transport")
+		body, _ := json.Marshal(&FlagStatus{ID: "123"})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+
+	client := NewClient(
+		WithHTTPClient(&http.Client{Transport: rt}),
+		WithMiddleware(recordingMiddleware("first", &order), recordingMiddleware("second", &order)),
+	)
+
+	if _, err := client.GetFlag("123", nil); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := []string{"first", "second", "transport"}
+	if len(order) != len(expected) {
+		t.Fatalf("Expected order %v, got %v", expected, order)
+	}
+	for i, name := range expected {
+		if order[i] != name {
+			t.Errorf("Expected order %v, got %v", expected, order)
+			break
+		}
+	}
+}
+
+func TestMiddleware_PanicIsRecoveredAndResponseClosed(t *testing.T) {
+	closed := false
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       &closeTrackingReader{r: strings.NewReader(`{"id":"123"}`), closed: &closed},
+		}, nil
+	})
+
+	panicking := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			next(req) // obtains the response, then panics before returning it
+			panic("boom")
+		}
+	}
+
+	client := NewClient(WithHTTPClient(&http.Client{Transport: rt}), WithMiddleware(panicking))
+
+	_, err := client.GetFlag("123", nil)
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), "middleware panic") {
+		t.Errorf("Expected error to mention the recovered panic, got: %v", err)
+	}
+	if !closed {
+		t.Error("Expected the response body to be closed despite the panic")
+	}
+}
+
+// closeTrackingReader is an io.ReadCloser that records whether Close was
+// called, so tests can assert a response body was released.
+type closeTrackingReader struct {
+	r      io.Reader
+	closed *bool
+}
+
+func (c *closeTrackingReader) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+func (c *closeTrackingReader) Close() error {
+	*c.closed = true
+	return nil
+}
@@ -0,0 +1,128 @@
+// Package openfeature implements an OpenFeature FeatureProvider backed by a
+// rocketflag.Client, so RocketFlag can be used through the OpenFeature SDK.
+package openfeature
+
+import (
+	"context"
+	"strings"
+
+	rocketflag "github.com/RocketFlag/go-sdk"
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+// Provider implements the OpenFeature FeatureProvider interface, resolving
+// flags through a rocketflag.Client.
+type Provider struct {
+	client *rocketflag.Client
+}
+
+// NewProvider creates an OpenFeature Provider backed by client.
+func NewProvider(client *rocketflag.Client) *Provider {
+	return &Provider{client: client}
+}
+
+// Metadata returns the provider's name.
+func (p *Provider) Metadata() of.Metadata {
+	return of.Metadata{Name: "rocketflag"}
+}
+
+// Hooks returns no provider-level hooks.
+func (p *Provider) Hooks() []of.Hook {
+	return []of.Hook{}
+}
+
+// BooleanEvaluation resolves a boolean flag through RocketFlag.
+func (p *Provider) BooleanEvaluation(ctx context.Context, flag string, defaultValue bool, evalCtx of.FlattenedContext) of.BoolResolutionDetail {
+	status, detail, ok := p.resolve(ctx, flag, evalCtx)
+	if !ok {
+		return of.BoolResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+	}
+	return of.BoolResolutionDetail{Value: status.Enabled, ProviderResolutionDetail: detail}
+}
+
+// StringEvaluation always falls back to defaultValue: RocketFlag flags are
+// boolean-only today. It still resolves the flag so that a future
+// variant/value field on FlagStatus slots in here without an interface change.
+func (p *Provider) StringEvaluation(ctx context.Context, flag string, defaultValue string, evalCtx of.FlattenedContext) of.StringResolutionDetail {
+	_, detail, ok := p.resolve(ctx, flag, evalCtx)
+	if !ok {
+		return of.StringResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+	}
+	return of.StringResolutionDetail{Value: defaultValue, ProviderResolutionDetail: of.ProviderResolutionDetail{Reason: of.DefaultReason}}
+}
+
+// IntEvaluation always falls back to defaultValue: RocketFlag flags are
+// boolean-only today. It still resolves the flag so that a future
+// variant/value field on FlagStatus slots in here without an interface change.
+func (p *Provider) IntEvaluation(ctx context.Context, flag string, defaultValue int64, evalCtx of.FlattenedContext) of.IntResolutionDetail {
+	_, detail, ok := p.resolve(ctx, flag, evalCtx)
+	if !ok {
+		return of.IntResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+	}
+	return of.IntResolutionDetail{Value: defaultValue, ProviderResolutionDetail: of.ProviderResolutionDetail{Reason: of.DefaultReason}}
+}
+
+// FloatEvaluation always falls back to defaultValue: RocketFlag flags are
+// boolean-only today. It still resolves the flag so that a future
+// variant/value field on FlagStatus slots in here without an interface change.
+func (p *Provider) FloatEvaluation(ctx context.Context, flag string, defaultValue float64, evalCtx of.FlattenedContext) of.FloatResolutionDetail {
+	_, detail, ok := p.resolve(ctx, flag, evalCtx)
+	if !ok {
+		return of.FloatResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+	}
+	return of.FloatResolutionDetail{Value: defaultValue, ProviderResolutionDetail: of.ProviderResolutionDetail{Reason: of.DefaultReason}}
+}
+
+// ObjectEvaluation always falls back to defaultValue: RocketFlag flags are
+// boolean-only today. It still resolves the flag so that a future
+// variant/value field on FlagStatus slots in here without an interface change.
+func (p *Provider) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, evalCtx of.FlattenedContext) of.InterfaceResolutionDetail {
+	_, detail, ok := p.resolve(ctx, flag, evalCtx)
+	if !ok {
+		return of.InterfaceResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+	}
+	return of.InterfaceResolutionDetail{Value: defaultValue, ProviderResolutionDetail: of.ProviderResolutionDetail{Reason: of.DefaultReason}}
+}
+
+// resolve fetches flag from RocketFlag, translating any error into a
+// ProviderResolutionDetail with reason ERROR. ok is false when the caller
+// should fall back to its own default value.
+func (p *Provider) resolve(ctx context.Context, flag string, evalCtx of.FlattenedContext) (*rocketflag.FlagStatus, of.ProviderResolutionDetail, bool) {
+	status, err := p.client.GetFlagWithContext(ctx, flag, userContext(evalCtx))
+	if err != nil {
+		return nil, of.ProviderResolutionDetail{
+			ResolutionError: resolutionError(err),
+			Reason:          of.ErrorReason,
+		}, false
+	}
+	return status, of.ProviderResolutionDetail{Reason: of.TargetingMatchReason}, true
+}
+
+// userContext translates an OpenFeature evaluation context into a
+// rocketflag.UserContext, mapping the targeting key to "id".
+func userContext(evalCtx of.FlattenedContext) rocketflag.UserContext {
+	uc := make(rocketflag.UserContext, len(evalCtx))
+	for k, v := range evalCtx {
+		if k == of.TargetingKey {
+			uc["id"] = v
+			continue
+		}
+		uc[k] = v
+	}
+	return uc
+}
+
+// resolutionError classifies a rocketflag.Client error into the OpenFeature
+// error taxonomy based on the wrapped error message, since the client does
+// not expose a typed error for these cases.
+func resolutionError(err error) of.ResolutionError {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "error decoding response"):
+		return of.NewParseErrorResolutionError(msg)
+	case strings.Contains(msg, "error from server: 404"):
+		return of.NewFlagNotFoundResolutionError(msg)
+	default:
+		return of.NewGeneralResolutionError(msg)
+	}
+}
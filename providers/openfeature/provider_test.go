@@ -0,0 +1,117 @@
+package openfeature_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	rocketflag "github.com/RocketFlag/go-sdk"
+	rfopenfeature "github.com/RocketFlag/go-sdk/providers/openfeature"
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+// roundTripFunc is an adapter to allow the use of ordinary functions as
+// http.RoundTrippers in tests.
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func newOpenFeatureClient(t *testing.T, rt roundTripFunc) *of.Client {
+	t.Helper()
+
+	client := rocketflag.NewClient(rocketflag.WithHTTPClient(&http.Client{Transport: rt}))
+	provider := rfopenfeature.NewProvider(client)
+
+	if err := of.SetProviderAndWait(provider); err != nil {
+		t.Fatalf("Expected no error setting provider, got: %v", err)
+	}
+
+	return of.NewClient("rocketflag-test")
+}
+
+func TestProvider_BooleanEvaluation_TargetingMatch(t *testing.T) {
+	var capturedID string
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		capturedID = req.URL.Query().Get("id")
+		body, _ := json.Marshal(&rocketflag.FlagStatus{ID: "new-ui", Enabled: true})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+
+	ofClient := newOpenFeatureClient(t, rt)
+
+	value, err := ofClient.BooleanValue(context.Background(), "new-ui", false, of.NewEvaluationContext("user-1", nil))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !value {
+		t.Error("Expected true, got false")
+	}
+	if capturedID != "user-1" {
+		t.Errorf("Expected targeting key translated to id=user-1, got %q", capturedID)
+	}
+}
+
+func TestProvider_BooleanEvaluation_FlagNotFound(t *testing.T) {
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found", Body: io.NopCloser(bytes.NewReader(nil))}, nil
+	})
+
+	ofClient := newOpenFeatureClient(t, rt)
+
+	value, err := ofClient.BooleanValue(context.Background(), "missing", false, of.NewEvaluationContext("user-1", nil))
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	if value != false {
+		t.Errorf("Expected fallback to defaultValue, got %v", value)
+	}
+
+	details, err := ofClient.BooleanValueDetails(context.Background(), "missing", false, of.NewEvaluationContext("user-1", nil))
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	if details.ErrorCode != of.FlagNotFoundCode {
+		t.Errorf("Expected error code %q, got %q", of.FlagNotFoundCode, details.ErrorCode)
+	}
+}
+
+func TestProvider_BooleanEvaluation_ParseError(t *testing.T) {
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte("not json")))}, nil
+	})
+
+	ofClient := newOpenFeatureClient(t, rt)
+
+	details, err := ofClient.BooleanValueDetails(context.Background(), "broken", false, of.NewEvaluationContext("user-1", nil))
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	if details.ErrorCode != of.ParseErrorCode {
+		t.Errorf("Expected error code %q, got %q", of.ParseErrorCode, details.ErrorCode)
+	}
+}
+
+func TestProvider_StringEvaluation_FallsBackToDefault(t *testing.T) {
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := json.Marshal(&rocketflag.FlagStatus{ID: "new-ui", Enabled: true})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	})
+
+	ofClient := newOpenFeatureClient(t, rt)
+
+	details, err := ofClient.StringValueDetails(context.Background(), "new-ui", "fallback", of.NewEvaluationContext("user-1", nil))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if details.Value != "fallback" {
+		t.Errorf("Expected fallback value, got %q", details.Value)
+	}
+	if details.Reason != of.DefaultReason {
+		t.Errorf("Expected reason DEFAULT, got %q", details.Reason)
+	}
+}
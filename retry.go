@@ -0,0 +1,131 @@
+package rocketflag
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryConditional decides, given the response and error from a single
+// attempt, whether the request should be retried. resp is nil if the attempt
+// failed before a response was received.
+type RetryConditional func(resp *http.Response, err error) bool
+
+// DefaultRetryConditional retries network errors and responses that are
+// typically transient: 429 (rate limited), 502, 503, and 504.
+func DefaultRetryConditional(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// WithRetry enables retries for failed requests, issuing up to max additional
+// attempts with exponential backoff starting at base and capped at maxDelay,
+// each delay jittered by ±25%.
+func WithRetry(max int, base, maxDelay time.Duration) ClientOption {
+	return func(c *Client) {
+		c.maxRetries = max
+		c.retryBaseDelay = base
+		c.retryMaxDelay = maxDelay
+	}
+}
+
+// WithRetryConditional overrides the predicate used to decide whether an
+// attempt should be retried. The default is DefaultRetryConditional.
+func WithRetryConditional(cond RetryConditional) ClientOption {
+	return func(c *Client) {
+		c.retryConditional = cond
+	}
+}
+
+// do sends req through the middleware chain, retrying according to the
+// Client's retry configuration. The response body of any retried attempt is
+// drained and closed so the underlying connection can be reused.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	cond := c.retryConditional
+	if cond == nil {
+		cond = DefaultRetryConditional
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.roundTripAttempt(req)
+
+		if attempt >= c.maxRetries || !cond(resp, err) {
+			if err != nil && attempt > 0 {
+				return nil, fmt.Errorf("after %d attempts: %w", attempt+1, err)
+			}
+			return resp, err
+		}
+
+		delay := retryDelay(c.retryBaseDelay, c.retryMaxDelay, attempt)
+		if resp != nil {
+			if ra, ok := retryAfterDelay(resp.Header.Get("Retry-After")); ok {
+				delay = ra
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+
+		next := req.Clone(req.Context())
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("error rebuilding request body: %w", err)
+			}
+			next.Body = body
+		}
+		req = next
+	}
+}
+
+// retryDelay computes base*2^attempt capped at maxDelay, jittered by ±25%.
+func retryDelay(base, maxDelay time.Duration, attempt int) time.Duration {
+	delay := base << attempt
+	if delay <= 0 || delay > maxDelay {
+		delay = maxDelay
+	}
+
+	jitter := time.Duration((rand.Float64()*0.5 - 0.25) * float64(delay))
+	delay += jitter
+	if delay < 0 {
+		delay = 0
+	}
+	return delay
+}
+
+// retryAfterDelay parses a Retry-After header value, which may be a number
+// of seconds or an HTTP date, returning ok=false if the header is absent or
+// unparseable.
+func retryAfterDelay(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(header); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(header); err == nil {
+		if d := time.Until(when); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,144 @@
+package rocketflag
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingRoundTripper records how many times RoundTrip was called and
+// returns the response/error at the corresponding index, repeating the last
+// entry once exhausted.
+type countingRoundTripper struct {
+	calls     int32
+	responses []*http.Response
+	errs      []error
+}
+
+func (c *countingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	i := int(atomic.AddInt32(&c.calls, 1)) - 1
+	if i >= len(c.responses) {
+		i = len(c.responses) - 1
+	}
+	return c.responses[i], c.errs[i]
+}
+
+func (c *countingRoundTripper) calledTimes() int {
+	return int(atomic.LoadInt32(&c.calls))
+}
+
+func resp(status int, header http.Header) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		StatusCode: status,
+		Status:     http.StatusText(status),
+		Body:       io.NopCloser(bytes.NewReader(nil)),
+		Header:     header,
+	}
+}
+
+func TestRetry_RetriesUntilSuccess(t *testing.T) {
+	expectedFlag := &FlagStatus{Name: "test-flag", Enabled: true, ID: "123"}
+	flagJSON, _ := json.Marshal(expectedFlag)
+
+	rt := &countingRoundTripper{
+		responses: []*http.Response{
+			resp(http.StatusServiceUnavailable, nil),
+			resp(http.StatusServiceUnavailable, nil),
+			{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(flagJSON))},
+		},
+		errs: []error{nil, nil, nil},
+	}
+
+	client := NewClient(
+		WithHTTPClient(&http.Client{Transport: rt}),
+		WithRetry(3, time.Millisecond, 5*time.Millisecond),
+	)
+
+	flag, err := client.GetFlag("123", nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if flag.ID != expectedFlag.ID {
+		t.Errorf("Expected flag %+v, got %+v", expectedFlag, flag)
+	}
+	if rt.calledTimes() != 3 {
+		t.Errorf("Expected 3 attempts, got %d", rt.calledTimes())
+	}
+}
+
+func TestRetry_NonRetriable4xxFailsImmediately(t *testing.T) {
+	rt := &countingRoundTripper{
+		responses: []*http.Response{resp(http.StatusNotFound, nil)},
+		errs:      []error{nil},
+	}
+
+	client := NewClient(
+		WithHTTPClient(&http.Client{Transport: rt}),
+		WithRetry(3, time.Millisecond, 5*time.Millisecond),
+	)
+
+	_, err := client.GetFlag("123", nil)
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	if rt.calledTimes() != 1 {
+		t.Errorf("Expected 1 attempt, got %d", rt.calledTimes())
+	}
+}
+
+func TestRetry_ExhaustsAttemptsAndWrapsError(t *testing.T) {
+	mockErr := errors.New("network down")
+	rt := &countingRoundTripper{
+		responses: []*http.Response{nil, nil, nil},
+		errs:      []error{mockErr, mockErr, mockErr},
+	}
+
+	client := NewClient(
+		WithHTTPClient(&http.Client{Transport: rt}),
+		WithRetry(2, time.Millisecond, 5*time.Millisecond),
+	)
+
+	_, err := client.GetFlag("123", nil)
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), "after 3 attempts") {
+		t.Errorf("Expected error to mention attempt count, got: %v", err)
+	}
+	if rt.calledTimes() != 3 {
+		t.Errorf("Expected 3 attempts, got %d", rt.calledTimes())
+	}
+}
+
+func TestRetry_HonorsRetryAfterSeconds(t *testing.T) {
+	rt := &countingRoundTripper{
+		responses: []*http.Response{
+			resp(http.StatusServiceUnavailable, http.Header{"Retry-After": []string{"0"}}),
+			{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(`{"id":"123"}`)))},
+		},
+		errs: []error{nil, nil},
+	}
+
+	client := NewClient(
+		WithHTTPClient(&http.Client{Transport: rt}),
+		WithRetry(1, time.Hour, time.Hour), // would block forever without Retry-After honored
+	)
+
+	start := time.Now()
+	_, err := client.GetFlag("123", nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("Expected Retry-After to override the computed backoff, took %s", time.Since(start))
+	}
+}
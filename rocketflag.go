@@ -1,10 +1,12 @@
 package rocketflag
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 // FlagStatus represents the status of a feature flag.
@@ -19,9 +21,21 @@ type UserContext map[string]interface{}
 
 // Client is a RocketFlag API client.
 type Client struct {
-	version string
-	apiUrl  string
-	client  *http.Client
+	version        string
+	apiUrl         string
+	client         *http.Client
+	defaultTimeout time.Duration
+
+	maxRetries       int
+	retryBaseDelay   time.Duration
+	retryMaxDelay    time.Duration
+	retryConditional RetryConditional
+
+	cache *flagCache
+
+	batchConcurrency int
+
+	middleware []Middleware
 }
 
 // ClientOption defines a function type that modifies the Client.
@@ -48,6 +62,14 @@ func WithHTTPClient(client *http.Client) ClientOption {
 	}
 }
 
+// WithDefaultTimeout sets a default timeout that is applied to the context
+// passed to API calls when the context does not already have a deadline.
+func WithDefaultTimeout(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.defaultTimeout = d
+	}
+}
+
 // NewClient creates a new Client with optional configurations.
 func NewClient(opts ...ClientOption) *Client {
 	// Default values
@@ -66,11 +88,61 @@ func NewClient(opts ...ClientOption) *Client {
 }
 
 // GetFlag retrieves a feature flag from the RocketFlag API.
+//
+// It is a thin wrapper around GetFlagWithContext using context.Background().
+// Use GetFlagWithContext directly to support cancellation or deadlines.
 func (c *Client) GetFlag(flagID string, userContext UserContext) (*FlagStatus, error) {
+	return c.GetFlagWithContext(context.Background(), flagID, userContext)
+}
+
+// GetFlagWithContext retrieves a feature flag from the RocketFlag API, honoring
+// cancellation and deadlines carried on ctx. If ctx has no deadline and the
+// Client was configured with WithDefaultTimeout, that timeout is applied.
+//
+// If the Client was configured with WithCache, a cache hit is returned without
+// making a request, and concurrent misses for the same flag and user context
+// are coalesced into a single request.
+func (c *Client) GetFlagWithContext(ctx context.Context, flagID string, userContext UserContext) (*FlagStatus, error) {
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline && c.defaultTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.defaultTimeout)
+		defer cancel()
+	}
+
+	if c.cache == nil {
+		flag, _, err := c.fetchFlag(ctx, flagID, userContext)
+		return flag, err
+	}
+
+	key := cacheKey(flagID, userContext)
+	if flag, ok := c.cache.get(key); ok {
+		return flag, nil
+	}
+
+	v, err, _ := c.cache.group.Do(key, func() (interface{}, error) {
+		flag, ttl, err := c.fetchFlag(ctx, flagID, userContext)
+		if err != nil {
+			return nil, err
+		}
+		if ttl >= 0 {
+			c.cache.set(key, flag, ttl)
+		}
+		return flag, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*FlagStatus), nil
+}
 
+// fetchFlag issues the HTTP request for a flag lookup, bypassing the cache.
+// The returned duration is the TTL the response should be cached for,
+// derived from the server's Cache-Control header when caching is enabled;
+// it is meaningless when the Client has no cache.
+func (c *Client) fetchFlag(ctx context.Context, flagID string, userContext UserContext) (*FlagStatus, time.Duration, error) {
 	u, err := url.Parse(fmt.Sprintf("%s/%s/flags/%s", c.apiUrl, c.version, flagID))
 	if err != nil {
-		return nil, fmt.Errorf("error parsing URL: %w", err)
+		return nil, 0, fmt.Errorf("error parsing URL: %w", err)
 	}
 
 	q := u.Query()
@@ -79,25 +151,25 @@ func (c *Client) GetFlag(flagID string, userContext UserContext) (*FlagStatus, e
 	}
 	u.RawQuery = q.Encode()
 
-	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, 0, fmt.Errorf("error creating request: %w", err)
 	}
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
-		return nil, fmt.Errorf("error making request: %w", err)
+		return nil, 0, fmt.Errorf("error making request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error from server: %s", resp.Status)
+		return nil, 0, fmt.Errorf("error from server: %s", resp.Status)
 	}
 
 	var flag FlagStatus
 	if err := json.NewDecoder(resp.Body).Decode(&flag); err != nil {
-		return nil, fmt.Errorf("error decoding response: %w", err)
+		return nil, 0, fmt.Errorf("error decoding response: %w", err)
 	}
 
-	return &flag, nil
+	return &flag, cacheTTL(c, resp), nil
 }
@@ -2,6 +2,7 @@ package rocketflag
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 // Mocking the http.RoundTripper interface to control HTTP responses
@@ -221,10 +223,63 @@ func TestGetFlag_UserContext(t *testing.T) {
 	}
 }
 
-// RoundTripFunc is an adapter to allow the use of ordinary functions as RoundTrippers.
-type RoundTripFunc func(req *http.Request) (*http.Response, error)
+func TestGetFlagWithContext_Cancelled(t *testing.T) {
+	// Create a client whose transport blocks until the request context is done.
+	client := NewClient(WithHTTPClient(&http.Client{
+		Transport: RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}),
+	}))
 
-// RoundTrip implements the RoundTripper interface.
-func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
-	return f(req)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetFlagWithContext(ctx, "123", nil)
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected error to wrap context.Canceled, got: %v", err)
+	}
+}
+
+func TestGetFlagWithContext_DeadlineExceeded(t *testing.T) {
+	client := NewClient(WithHTTPClient(&http.Client{
+		Transport: RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}),
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetFlagWithContext(ctx, "123", nil)
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected error to wrap context.DeadlineExceeded, got: %v", err)
+	}
+}
+
+func TestWithDefaultTimeout_AppliedWhenNoDeadline(t *testing.T) {
+	client := NewClient(
+		WithDefaultTimeout(time.Millisecond),
+		WithHTTPClient(&http.Client{
+			Transport: RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+				<-req.Context().Done()
+				return nil, req.Context().Err()
+			}),
+		}),
+	)
+
+	_, err := client.GetFlagWithContext(context.Background(), "123", nil)
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected error to wrap context.DeadlineExceeded, got: %v", err)
+	}
 }